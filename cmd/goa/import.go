@@ -0,0 +1,76 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	imp "goa.design/goa.v2/import"
+	"goa.design/goa.v2/import/discovery"
+	"goa.design/goa.v2/import/openapi"
+)
+
+// importCommand implements "goa import", it reads an external API
+// description and writes an equivalent goa design package under the
+// directory named by -out. The source format is picked with -from, the
+// only two supported values being "openapi" (OpenAPI 3 document) and
+// "discovery" (Google-style discovery document). Both are read as JSON: goa
+// has no YAML dependency, so a YAML OpenAPI document must be converted to
+// JSON (e.g. with a standalone yq/js-yaml conversion step) before it is
+// passed to this command.
+func importCommand(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	from := fs.String("from", "openapi", `source format, one of "openapi" or "discovery"`)
+	out := fs.String("out", "design", "output directory for the generated design package")
+	name := fs.String("pkg", "", "generated API name, defaults to the OpenAPI document title or the discovery document name")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: goa import -from=openapi|discovery [-out=design] [-pkg=name] FILE")
+	}
+	path := fs.Arg(0)
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("import: failed to read %s: %s", path, err)
+	}
+
+	var api *imp.API
+	switch *from {
+	case "openapi":
+		doc := new(openapi.Document)
+		if err := json.Unmarshal(data, doc); err != nil {
+			return fmt.Errorf("import: failed to parse %s: %s", path, err)
+		}
+		if api, err = openapi.Import(*name, doc); err != nil {
+			return err
+		}
+	case "discovery":
+		doc := new(discovery.Document)
+		if err := json.Unmarshal(data, doc); err != nil {
+			return fmt.Errorf("import: failed to parse %s: %s", path, err)
+		}
+		if api, err = discovery.Import(*name, doc); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("import: unknown source format %#v, must be \"openapi\" or \"discovery\"", *from)
+	}
+
+	src, err := imp.Generate(api.Name, api)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(*out, 0755); err != nil {
+		return fmt.Errorf("import: failed to create %s: %s", *out, err)
+	}
+	dest := filepath.Join(*out, "design.go")
+	if err := ioutil.WriteFile(dest, src, 0644); err != nil {
+		return fmt.Errorf("import: failed to write %s: %s", dest, err)
+	}
+	fmt.Printf("generated %s\n", dest)
+	return nil
+}
@@ -0,0 +1,25 @@
+// Command goa is the goa CLI.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: goa COMMAND [ARGS]")
+		os.Exit(1)
+	}
+	var err error
+	switch os.Args[1] {
+	case "import":
+		err = importCommand(os.Args[2:])
+	default:
+		err = fmt.Errorf("unknown command %#v", os.Args[1])
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,65 @@
+package codegen
+
+import (
+	"path"
+	"sort"
+	"text/template"
+
+	"goa.design/goa.v2/rest/design"
+)
+
+var (
+	encodingRegistryT *template.Template
+	encodingImportsT  *template.Template
+)
+
+func init() {
+	var err error
+	fm := template.FuncMap{"pkgName": func(importPath string) string { return path.Base(importPath) }}
+	if encodingRegistryT, err = template.New("encodingRegistry").Funcs(fm).Parse(encodingRegistryTmpl); err != nil {
+		panic(err)
+	}
+	if encodingImportsT, err = template.New("encodingImports").Parse(encodingImportsTmpl); err != nil {
+		panic(err)
+	}
+}
+
+// EncodingRegistry produces the code that initializes the server encoder
+// registry in main.go. The registry always includes design.DefaultEncodings
+// (JSON, XML and gob) in addition to every encoding registered via the
+// Encoding DSL, each registered by calling its package function (see
+// EncodingImports for the matching import declarations).
+func EncodingRegistry(encodings []*design.EncodingExpr) string {
+	return RunTemplate(encodingRegistryT, map[string]interface{}{"encodings": allEncodings(encodings)})
+}
+
+// EncodingImports produces the import declarations for the packages
+// referenced by EncodingRegistry, so that main.go compiles: the registry
+// calls {{pkgName .PackagePath}}.{{.Function}} for every registered
+// encoding, which requires importing PackagePath alongside the rest of
+// main.go's own imports. Each distinct package path is imported once.
+func EncodingImports(encodings []*design.EncodingExpr) string {
+	seen := make(map[string]struct{})
+	var paths []string
+	for _, enc := range allEncodings(encodings) {
+		if _, ok := seen[enc.PackagePath]; ok {
+			continue
+		}
+		seen[enc.PackagePath] = struct{}{}
+		paths = append(paths, enc.PackagePath)
+	}
+	sort.Strings(paths)
+	return RunTemplate(encodingImportsT, map[string]interface{}{"paths": paths})
+}
+
+// allEncodings returns the encodings registered via the Encoding DSL in
+// addition to design.DefaultEncodings (JSON, XML and gob).
+func allEncodings(encodings []*design.EncodingExpr) []*design.EncodingExpr {
+	return append(append([]*design.EncodingExpr{}, design.DefaultEncodings...), encodings...)
+}
+
+const encodingRegistryTmpl = `var encoders = rest.NewEncoderRegistry(){{ range .encodings }}{{ $enc := . }}{{ $pkg := pkgName .PackagePath }}{{ range .MIMETypes }}
+encoders.Register({{ printf "%q" . }}, {{ $pkg }}.{{ $enc.Function }}){{ end }}{{ end }}`
+
+const encodingImportsTmpl = `{{ range .paths }}	{{ printf "%q" . }}
+{{ end }}`
@@ -0,0 +1,42 @@
+package codegen
+
+import (
+	"text/template"
+
+	"goa.design/goa.v2/rest/design"
+)
+
+var negotiateResponseT *template.Template
+
+func init() {
+	var err error
+	fm := template.FuncMap{
+		"tabs":  Tabs,
+		"goify": Goify,
+	}
+	if negotiateResponseT, err = template.New("negotiateResponse").Funcs(fm).Parse(negotiateResponseTmpl); err != nil {
+		panic(err)
+	}
+}
+
+// NegotiateResponse produces the code that picks the response body to render
+// given the request "Accept" header and the media types registered on the
+// given response via the Body DSL. It returns the empty string if the
+// response only declares a single body (there is nothing to negotiate).
+func NegotiateResponse(r *design.HTTPResponseExpr, respVar string) string {
+	if len(r.Bodies) < 2 {
+		return ""
+	}
+	return RunTemplate(negotiateResponseT, map[string]interface{}{
+		"response": r,
+		"respVar":  respVar,
+	})
+}
+
+const negotiateResponseTmpl = `switch negotiateContentType(r){{/*
+*/}}{{ range .response.Bodies }}
+case {{ printf "%q" .MediaType }}:
+	return {{ $.respVar }}.RenderAs{{ goify .MediaType true }}(ctx, rw){{ end }}
+default:
+	return {{ $.respVar }}.Render(ctx, rw)
+}`
@@ -0,0 +1,65 @@
+package discovery
+
+import (
+	"sort"
+
+	"goa.design/goa.v2/codegen"
+	imp "goa.design/goa.v2/import"
+)
+
+// schemaToMediaType converts a discovery schema declared under the "schemas"
+// top-level map into an imp.MediaType, resolving "$ref" properties against
+// the same map so that nested object properties referencing another schema
+// are represented using that schema's Go type name rather than duplicated
+// inline.
+func schemaToMediaType(id string, s *Schema, all map[string]*Schema) *imp.MediaType {
+	mt := &imp.MediaType{
+		Identifier:  "application/json",
+		TypeName:    codegen.Goify(id, true) + "Media",
+		Description: s.Description,
+		Ref:         id,
+	}
+	names := make([]string, 0, len(s.Properties))
+	for n := range s.Properties {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		p := s.Properties[n]
+		mt.Attributes = append(mt.Attributes, &imp.Attribute{
+			Name:        n,
+			Type:        discoveryTypeName(p, all),
+			Description: p.Description,
+			Required:    p.Required,
+		})
+	}
+	return mt
+}
+
+// discoveryTypeName maps a discovery schema "type" to the goa DSL primitive
+// identifier used in Attribute calls. A property that references another
+// schema via "$ref" resolves to that schema's media type Go variable name
+// instead of a primitive.
+func discoveryTypeName(s *Schema, all map[string]*Schema) string {
+	if s == nil {
+		return "String"
+	}
+	if s.Ref != "" {
+		if ref, ok := all[s.Ref]; ok {
+			return codegen.Goify(ref.ID, true) + "Media"
+		}
+		return codegen.Goify(s.Ref, true) + "Media"
+	}
+	switch s.Type {
+	case "integer":
+		return "Integer"
+	case "number":
+		return "Number"
+	case "boolean":
+		return "Boolean"
+	case "array":
+		return "ArrayOf(String)"
+	default:
+		return "String"
+	}
+}
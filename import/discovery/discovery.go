@@ -0,0 +1,162 @@
+// Package discovery imports Google-style API discovery documents and
+// produces the shared imp.API intermediate representation consumed by the
+// DSL generator in goa.design/goa.v2/import.
+package discovery
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	"goa.design/goa.v2/codegen"
+	imp "goa.design/goa.v2/import"
+)
+
+// Document is the minimal subset of the discovery document object model
+// needed to synthesize an imp.API.
+type Document struct {
+	Name        string                          `json:"name"`
+	Title       string                          `json:"title"`
+	Description string                          `json:"description"`
+	RootURL     string                          `json:"rootUrl"`
+	BasePath    string                          `json:"basePath"`
+	Schemas     map[string]*Schema              `json:"schemas"`
+	Resources   map[string]*ResourceDescription `json:"resources"`
+	Methods     map[string]*Method              `json:"methods"`
+}
+
+// Schema is the minimal discovery schema object model.
+type Schema struct {
+	ID          string             `json:"id"`
+	Description string             `json:"description"`
+	Type        string             `json:"type"`
+	Properties  map[string]*Schema `json:"properties"`
+	Ref         string             `json:"$ref"`
+	Required    bool               `json:"required"`
+}
+
+// ResourceDescription groups the methods exposed by a discovery resource,
+// discovery documents nest resources arbitrarily deep but this importer
+// only looks one level down which covers the vast majority of documents.
+type ResourceDescription struct {
+	Methods map[string]*Method `json:"methods"`
+}
+
+// Method describes a single discovery method.
+type Method struct {
+	ID             string             `json:"id"`
+	Path           string             `json:"path"`
+	HTTPMethod     string             `json:"httpMethod"`
+	Description    string             `json:"description"`
+	Parameters     map[string]*Schema `json:"parameters"`
+	ParameterOrder []string           `json:"parameterOrder"`
+	Request        *Ref               `json:"request"`
+	Response       *Ref               `json:"response"`
+}
+
+// Ref references a schema declared in Document.Schemas by ID.
+type Ref struct {
+	Ref string `json:"$ref"`
+}
+
+// Import converts doc into the shared intermediate representation. Each
+// discovery schema becomes a MediaType deduped by its ID, and each method -
+// whether declared at the top level or nested one level under a resource -
+// becomes an Action on the resource derived from its containing group (top
+// level methods are grouped under the API name).
+func Import(name string, doc *Document) (*imp.API, error) {
+	if name == "" {
+		name = doc.Name
+	}
+	api := &imp.API{
+		Name:        name,
+		Title:       doc.Title,
+		Description: doc.Description,
+		BasePath:    doc.BasePath,
+		Schemes:     []string{"https"},
+	}
+	if u, err := url.Parse(doc.RootURL); err == nil {
+		api.Host = u.Host
+	}
+
+	schemaIDs := make([]string, 0, len(doc.Schemas))
+	for id := range doc.Schemas {
+		schemaIDs = append(schemaIDs, id)
+	}
+	sort.Strings(schemaIDs)
+	mediaTypes := make(map[string]*imp.MediaType, len(doc.Schemas))
+	for _, id := range schemaIDs {
+		mt := schemaToMediaType(id, doc.Schemas[id], doc.Schemas)
+		mediaTypes[id] = mt
+		api.MediaTypes = append(api.MediaTypes, mt)
+	}
+
+	addResource := func(resName string, methods map[string]*Method) {
+		if len(methods) == 0 {
+			return
+		}
+		res := &imp.Resource{Name: codegen.Goify(resName, true)}
+		ids := make([]string, 0, len(methods))
+		for id := range methods {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			res.Actions = append(res.Actions, methodToAction(methods[id], mediaTypes))
+		}
+		api.Resources = append(api.Resources, res)
+	}
+	addResource(name, doc.Methods)
+	resNames := make([]string, 0, len(doc.Resources))
+	for resName := range doc.Resources {
+		resNames = append(resNames, resName)
+	}
+	sort.Strings(resNames)
+	for _, resName := range resNames {
+		addResource(resName, doc.Resources[resName].Methods)
+	}
+	return api, nil
+}
+
+func methodToAction(m *Method, mediaTypes map[string]*imp.MediaType) *imp.Action {
+	act := &imp.Action{
+		Name:        codegen.Goify(m.ID, true),
+		Description: m.Description,
+		Method:      strings.ToUpper(m.HTTPMethod),
+		Path:        m.Path,
+	}
+	required := make(map[string]bool, len(m.ParameterOrder))
+	for _, p := range m.ParameterOrder {
+		required[p] = true
+	}
+	paramNames := make([]string, 0, len(m.Parameters))
+	for n := range m.Parameters {
+		paramNames = append(paramNames, n)
+	}
+	sort.Strings(paramNames)
+	for _, n := range paramNames {
+		p := m.Parameters[n]
+		act.Params = append(act.Params, &imp.Attribute{
+			Name:        n,
+			Type:        discoveryTypeName(p, nil),
+			Description: p.Description,
+			Required:    required[n] || p.Required,
+		})
+	}
+	if m.Request != nil {
+		if mt, ok := mediaTypes[m.Request.Ref]; ok {
+			act.Payload = mt.TypeName
+		}
+	}
+	if m.Response != nil {
+		if mt, ok := mediaTypes[m.Response.Ref]; ok {
+			act.Responses = append(act.Responses, &imp.Response{
+				Status: 200,
+				Bodies: []*imp.Body{{MIMEType: "application/json", MediaType: mt.TypeName}},
+			})
+		}
+	} else {
+		act.Responses = append(act.Responses, &imp.Response{Status: 204})
+	}
+	return act
+}
@@ -0,0 +1,38 @@
+package discovery
+
+import (
+	"strings"
+	"testing"
+
+	imp "goa.design/goa.v2/import"
+)
+
+func TestImportResponseBody(t *testing.T) {
+	doc := &Document{
+		Name: "bottles",
+		Schemas: map[string]*Schema{
+			"Bottle": {ID: "Bottle", Type: "object"},
+		},
+		Methods: map[string]*Method{
+			"bottles.get": {
+				ID:         "bottles.get",
+				Path:       "bottles/{id}",
+				HTTPMethod: "GET",
+				Response:   &Ref{Ref: "Bottle"},
+			},
+		},
+	}
+
+	api, err := Import("", doc)
+	if err != nil {
+		t.Fatalf("Import failed: %s", err)
+	}
+	src, err := imp.Generate("design", api)
+	if err != nil {
+		t.Fatalf("Generate failed: %s", err)
+	}
+	out := string(src)
+	if want := `Body("application/json", BottleMedia)`; !strings.Contains(out, want) {
+		t.Errorf("generated design missing %q, got:\n%s", want, out)
+	}
+}
@@ -0,0 +1,144 @@
+package imp
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"text/template"
+
+	"goa.design/goa.v2/codegen"
+)
+
+// statusName returns the standard HTTP status name goa uses by default for
+// the given status code (e.g. 200 -> "OK"), or the status code itself
+// formatted as a string when it has no standard name. Generate uses it to
+// decide whether it must emit an explicit Status(n) call: the default
+// response template already sets the status for standard names.
+func statusName(status int) string {
+	if text := http.StatusText(status); text != "" {
+		return codegen.Goify(text, true)
+	}
+	return fmt.Sprintf("%d", status)
+}
+
+// Generate renders api as a compilable goa design package. The generated
+// source declares one MediaType per api.MediaTypes entry (deduped by Ref by
+// the importer) and one Resource/Action pair per api.Resources entry,
+// including a Response(StatusName, ...) call for every operation response -
+// omitting the Status DSL call whenever the status name is a standard one
+// since the default response template already covers it, and emitting one
+// Body(mime, media) call per Response.Bodies entry so a response that
+// declares several content types keeps all of them.
+func Generate(pkg string, api *API) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := designT.Execute(&buf, map[string]interface{}{
+		"pkg": pkg,
+		"api": api,
+	}); err != nil {
+		return nil, fmt.Errorf("import: failed to generate design: %s", err)
+	}
+	return buf.Bytes(), nil
+}
+
+var designT = template.Must(template.New("design").Funcs(template.FuncMap{
+	"goify":      codegen.Goify,
+	"statusName": statusName,
+	"isStandard": func(status int) bool { return http.StatusText(status) != "" },
+	"requiredNames": func(attrs []*Attribute) []string {
+		var names []string
+		for _, a := range attrs {
+			if a.Required {
+				names = append(names, a.Name)
+			}
+		}
+		return names
+	},
+}).Parse(designTmpl))
+
+const designTmpl = `package design
+
+import (
+	. "goa.design/goa.v2/dsl"
+)
+
+var _ = API("{{ .api.Name }}", func() {
+{{- if .api.Title }}
+	Title("{{ .api.Title }}")
+{{- end }}
+{{- if .api.Description }}
+	Description({{ printf "%q" .api.Description }})
+{{- end }}
+{{- if .api.Host }}
+	Host("{{ .api.Host }}")
+{{- end }}
+{{- if .api.BasePath }}
+	BasePath("{{ .api.BasePath }}")
+{{- end }}
+{{- range .api.Schemes }}
+	Scheme("{{ . }}")
+{{- end }}
+})
+{{ range .api.MediaTypes }}
+var {{ goify .TypeName true }} = MediaType("{{ .Identifier }}", func() {
+{{- if .Description }}
+	Description({{ printf "%q" .Description }})
+{{- end }}
+	Attributes(func() {
+{{- range .Attributes }}
+		Attribute("{{ .Name }}", {{ .Type }}{{ if .Description }}, {{ printf "%q" .Description }}{{ end }})
+{{- end }}
+{{- if .Attributes }}
+		Required(
+{{- range $i, $n := requiredNames .Attributes }}{{ if $i }}, {{ end }}"{{ $n }}"{{ end }}
+		)
+{{- end }}
+	})
+})
+{{ end }}
+{{ range .api.Resources }}
+var _ = Resource("{{ .Name }}", func() {
+{{- if .BasePath }}
+	BasePath("{{ .BasePath }}")
+{{- end }}
+{{- range .Actions }}
+	Action("{{ .Name }}", func() {
+{{- if .Description }}
+		Description({{ printf "%q" .Description }})
+{{- end }}
+		Routing({{ .Method }}("{{ .Path }}"))
+{{- if .Params }}
+		Params(func() {
+{{- range .Params }}
+			Param("{{ .Name }}", {{ .Type }}{{ if .Description }}, {{ printf "%q" .Description }}{{ end }})
+{{- end }}
+		})
+{{- end }}
+{{- if .Headers }}
+		Headers(func() {
+{{- range .Headers }}
+			Header("{{ .Name }}"{{ if .Description }}, {{ printf "%q" .Description }}{{ end }})
+{{- end }}
+		})
+{{- end }}
+{{- if .Payload }}
+		Payload({{ .Payload }})
+{{- end }}
+{{- range .Responses }}
+{{- if and (isStandard .Status) (not .Bodies) }}
+		Response({{ statusName .Status }})
+{{- else }}
+		Response({{ if isStandard .Status }}{{ statusName .Status }}{{ else }}{{ printf "%q" (statusName .Status) }}{{ end }}, func() {
+{{- if not (isStandard .Status) }}
+			Status({{ .Status }})
+{{- end }}
+{{- range .Bodies }}
+			Body({{ printf "%q" .MIMEType }}, {{ .MediaType }})
+{{- end }}
+		})
+{{- end }}
+{{- end }}
+	})
+{{- end }}
+})
+{{ end }}
+`
@@ -0,0 +1,122 @@
+// Package imp defines the intermediate representation produced by the
+// openapi and discovery importers and shared by the DSL generator. Having a
+// single model decouples the code that knows how to read an external API
+// description from the code that knows how to write goa DSL, so adding a
+// third source format only requires a new importer.
+package imp
+
+// API is the top level description of an imported API, it maps to a single
+// generated design package.
+type API struct {
+	// Name is the API name, used as the name given to the generated API
+	// DSL call. The generated package is always named "design".
+	Name string
+	// Title is the API title as declared in the source document.
+	Title string
+	// Description is the API description.
+	Description string
+	// Host is the API host, e.g. "api.example.com".
+	Host string
+	// BasePath is the common path prefix of all the API actions.
+	BasePath string
+	// Schemes lists the supported URL schemes, e.g. "https".
+	Schemes []string
+	// MediaTypes lists the media types referenced by the API responses
+	// and payloads, deduped by schema identifier ($ref).
+	MediaTypes []*MediaType
+	// Resources lists the API resources.
+	Resources []*Resource
+}
+
+// MediaType describes a schema imported from the source document, it maps
+// to a goa MediaType DSL definition.
+type MediaType struct {
+	// Identifier is the media type identifier, the MIME type of the content
+	// the schema was first imported for, e.g. "application/json".
+	Identifier string
+	// TypeName is the Go-ified type name used for the corresponding goa
+	// identifier, see codegen.Goify.
+	TypeName string
+	// Description is the schema description.
+	Description string
+	// Ref is the original schema reference ($ref) the media type was
+	// synthesized from, used to dedupe media types across operations.
+	Ref string
+	// Attributes lists the schema properties.
+	Attributes []*Attribute
+}
+
+// Attribute describes a single schema property or parameter.
+type Attribute struct {
+	// Name is the attribute name.
+	Name string
+	// Type is the goa primitive or media type name used to generate the
+	// Attribute DSL call, e.g. "String", "Int64" or a MediaType TypeName.
+	Type string
+	// Description is the attribute description.
+	Description string
+	// Required indicates whether the attribute is listed in the parent
+	// schema "required" array.
+	Required bool
+}
+
+// Resource groups the actions derived from operations that share the same
+// path prefix, it maps to a goa Resource DSL definition.
+type Resource struct {
+	// Name is the resource name.
+	Name string
+	// BasePath is the resource common path prefix.
+	BasePath string
+	// Actions lists the resource actions.
+	Actions []*Action
+}
+
+// Action describes a single operation, it maps to a goa Action DSL
+// definition.
+type Action struct {
+	// Name is the action name, derived from the operation ID via
+	// codegen.Goify.
+	Name string
+	// Description is the action description.
+	Description string
+	// Method is the HTTP method, e.g. "GET".
+	Method string
+	// Path is the action path relative to the resource base path.
+	Path string
+	// Params lists the path and query string parameters.
+	Params []*Attribute
+	// Headers lists the request headers.
+	Headers []*Attribute
+	// Payload is the Go variable name of the request body media type (see
+	// MediaType.TypeName), empty if the action takes no payload.
+	Payload string
+	// Responses lists the action responses.
+	Responses []*Response
+}
+
+// Response describes a single operation response, it maps to a goa Response
+// DSL definition.
+type Response struct {
+	// Name is the response name. The importers leave it empty: Generate
+	// derives the name from Status using the standard HTTP status names
+	// goa registers by default (e.g. 200 maps to "OK").
+	Name string
+	// Status is the response HTTP status code.
+	Status int
+	// Bodies lists the media-type/body pairs declared for this response,
+	// rendered as one Body(mime, media) call per entry so a response that
+	// declares several content types for the same status (content
+	// negotiation) round-trips through import instead of only keeping
+	// one of them. Empty when the response has no body.
+	Bodies []*Body
+}
+
+// Body pairs a MIME type with the Go variable name of the MediaType used to
+// render it for a Response, mirroring the rest/design/dsl Body DSL.
+type Body struct {
+	// MIMEType is the body media type identifier, e.g. "application/json".
+	MIMEType string
+	// MediaType is the Go variable name of the MediaType (see
+	// MediaType.TypeName) used to render it.
+	MediaType string
+}
@@ -0,0 +1,105 @@
+package openapi
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"goa.design/goa.v2/codegen"
+	imp "goa.design/goa.v2/import"
+)
+
+// schemaToMediaType converts an OpenAPI schema into an imp.MediaType. ref is
+// the schema's "$ref", if any, and is used to derive a stable type name. all
+// is the full "components/schemas" map and is threaded through so that
+// properties referencing another schema via "$ref" resolve to that schema's
+// MediaType Go variable name, see schemaTypeName.
+func schemaToMediaType(ref string, s *Schema, all map[string]*Schema) *imp.MediaType {
+	name := ref
+	if name == "" {
+		name = "AnonymousMedia"
+	} else if idx := strings.LastIndex(name, "/"); idx >= 0 {
+		name = name[idx+1:]
+	}
+	mt := &imp.MediaType{
+		TypeName:    codegen.Goify(name, true) + "Media",
+		Description: s.Description,
+		Ref:         ref,
+	}
+	required := make(map[string]bool, len(s.Required))
+	for _, r := range s.Required {
+		required[r] = true
+	}
+	propNames := make([]string, 0, len(s.Properties))
+	for n := range s.Properties {
+		propNames = append(propNames, n)
+	}
+	sort.Strings(propNames)
+	for _, n := range propNames {
+		p := s.Properties[n]
+		mt.Attributes = append(mt.Attributes, &imp.Attribute{
+			Name:        n,
+			Type:        schemaTypeName(p, all),
+			Description: p.Description,
+			Required:    required[n],
+		})
+	}
+	return mt
+}
+
+// schemaTypeName maps an OpenAPI schema to the goa DSL identifier used in
+// Attribute calls: either a primitive type name derived from s.Type, or, if
+// s references a component schema via "$ref", the Go variable name of the
+// MediaType synthesized for that schema (see schemaToMediaType).
+func schemaTypeName(s *Schema, all map[string]*Schema) string {
+	if s == nil {
+		return "String"
+	}
+	if s.Ref != "" {
+		name := s.Ref
+		if idx := strings.LastIndex(name, "/"); idx >= 0 {
+			name = name[idx+1:]
+		}
+		return codegen.Goify(name, true) + "Media"
+	}
+	switch s.Type {
+	case "integer":
+		return "Integer"
+	case "number":
+		return "Number"
+	case "boolean":
+		return "Boolean"
+	case "array":
+		return "ArrayOf(String)"
+	default:
+		return "String"
+	}
+}
+
+// resourceName derives a resource name from a path by keeping its first
+// static segment, e.g. "/bottles/{id}" -> "bottles".
+func resourceName(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for _, s := range segments {
+		if s != "" && !strings.HasPrefix(s, "{") {
+			return s
+		}
+	}
+	return "root"
+}
+
+// statusCode parses an OpenAPI response key ("200", "404", "default") into
+// an HTTP status code. "default" designates the fallback/error response and
+// is mapped to 500 rather than 200: it conventionally describes an error
+// condition, often the only response an operation declares besides its
+// success codes, and coercing it to OK would mislabel an error schema as a
+// success response.
+func statusCode(key string) int {
+	if key == "default" {
+		return 500
+	}
+	if code, err := strconv.Atoi(key); err == nil {
+		return code
+	}
+	return 500
+}
@@ -0,0 +1,234 @@
+// Package openapi imports OpenAPI 3 documents and produces the shared
+// imp.API intermediate representation consumed by the DSL generator in
+// goa.design/goa.v2/import. Import only reads the already-decoded Document
+// value: this package has no YAML dependency of its own, so YAML OpenAPI
+// documents must be converted to JSON before being decoded into a Document.
+
+package openapi
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"goa.design/goa.v2/codegen"
+	imp "goa.design/goa.v2/import"
+)
+
+// Document is the minimal subset of the OpenAPI 3 object model needed to
+// synthesize an imp.API. It is intentionally limited to the fields the
+// importer uses rather than mirroring the full specification.
+type Document struct {
+	OpenAPI string `json:"openapi"`
+	Info    struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	} `json:"info"`
+	Servers []struct {
+		URL string `json:"url"`
+	} `json:"servers"`
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	} `json:"components"`
+}
+
+// Operation describes a single OpenAPI operation (method + path entry).
+type Operation struct {
+	OperationID string                  `json:"operationId"`
+	Summary     string                  `json:"summary"`
+	Parameters  []*Param                `json:"parameters"`
+	RequestBody *RequestRef             `json:"requestBody"`
+	Responses   map[string]*ResponseRef `json:"responses"`
+}
+
+// Param describes an OpenAPI parameter object.
+type Param struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description"`
+	Required    bool    `json:"required"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestRef wraps the request body schema reference.
+type RequestRef struct {
+	Content map[string]*MediaTypeObject `json:"content"`
+}
+
+// ResponseRef wraps a response body schema reference.
+type ResponseRef struct {
+	Description string                      `json:"description"`
+	Content     map[string]*MediaTypeObject `json:"content"`
+}
+
+// MediaTypeObject associates a media type with its schema.
+type MediaTypeObject struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Schema is the minimal JSON Schema subset used by OpenAPI "schema" objects.
+type Schema struct {
+	Ref         string             `json:"$ref"`
+	Type        string             `json:"type"`
+	Description string             `json:"description"`
+	Properties  map[string]*Schema `json:"properties"`
+	Required    []string           `json:"required"`
+}
+
+// Import converts doc into the shared intermediate representation. Schemas
+// are deduped by their "$ref" so that an operation whose request body and
+// response reference the same component schema only produces a single
+// MediaType.
+func Import(name string, doc *Document) (*imp.API, error) {
+	if name == "" {
+		name = doc.Info.Title
+	}
+	api := &imp.API{
+		Name:        name,
+		Title:       doc.Info.Title,
+		Description: doc.Info.Description,
+		Schemes:     []string{"https"},
+	}
+	if len(doc.Servers) > 0 {
+		if u, err := url.Parse(doc.Servers[0].URL); err == nil {
+			api.Host = u.Host
+		}
+	}
+
+	// mediaTypeFor returns the MediaType for the given schema, creating it
+	// on first use. The schema's identifier is fixed to mime at creation
+	// time and never touched again: a later call for the same ref with a
+	// different mime reuses the existing MediaType rather than relabeling
+	// it, so the dedup is never mutated by a call site that doesn't own
+	// it (the first caller, in sorted traversal order, does).
+	seen := make(map[string]*imp.MediaType)
+	mediaTypeFor := func(ref string, s *Schema, mime string) *imp.MediaType {
+		if ref != "" {
+			if mt, ok := seen[ref]; ok {
+				return mt
+			}
+		}
+		mt := schemaToMediaType(ref, s, doc.Components.Schemas)
+		mt.Identifier = mime
+		if ref != "" {
+			seen[ref] = mt
+		}
+		api.MediaTypes = append(api.MediaTypes, mt)
+		return mt
+	}
+	schemaRefs := make([]string, 0, len(doc.Components.Schemas))
+	for ref := range doc.Components.Schemas {
+		schemaRefs = append(schemaRefs, ref)
+	}
+	sort.Strings(schemaRefs)
+	for _, ref := range schemaRefs {
+		mediaTypeFor("#/components/schemas/"+ref, doc.Components.Schemas[ref], "application/json")
+	}
+
+	byResource := make(map[string]*imp.Resource)
+	var order []string
+	paths := make([]string, 0, len(doc.Paths))
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		ops := doc.Paths[path]
+		resName := resourceName(path)
+		res, ok := byResource[resName]
+		if !ok {
+			res = &imp.Resource{Name: codegen.Goify(resName, true)}
+			byResource[resName] = res
+			order = append(order, resName)
+		}
+		methods := make([]string, 0, len(ops))
+		for method := range ops {
+			methods = append(methods, method)
+		}
+		sort.Strings(methods)
+		for _, method := range methods {
+			op := ops[method]
+			if op == nil {
+				continue
+			}
+			if op.OperationID == "" {
+				return nil, fmt.Errorf("openapi: operation %s %s is missing operationId", method, path)
+			}
+			act := &imp.Action{
+				Name:        codegen.Goify(op.OperationID, true),
+				Description: op.Summary,
+				Method:      strings.ToUpper(method),
+				Path:        path,
+			}
+			for _, p := range op.Parameters {
+				attr := &imp.Attribute{
+					Name:        p.Name,
+					Type:        schemaTypeName(p.Schema, doc.Components.Schemas),
+					Description: p.Description,
+					Required:    p.Required,
+				}
+				if p.In == "header" {
+					act.Headers = append(act.Headers, attr)
+				} else {
+					act.Params = append(act.Params, attr)
+				}
+			}
+			if op.RequestBody != nil {
+				// goa's Payload DSL references a single type, there is no
+				// equivalent of Body/content negotiation for request
+				// bodies, so a request declaring several content types
+				// for the same payload only keeps the lexicographically
+				// first one (picked deterministically by firstContent).
+				if mime, mto, ok := firstContent(op.RequestBody.Content); ok {
+					mt := mediaTypeFor(mto.Schema.Ref, mto.Schema, mime)
+					act.Payload = mt.TypeName
+				}
+			}
+			statuses := make([]string, 0, len(op.Responses))
+			for status := range op.Responses {
+				statuses = append(statuses, status)
+			}
+			sort.Strings(statuses)
+			for _, status := range statuses {
+				r := op.Responses[status]
+				resp := &imp.Response{Status: statusCode(status)}
+				mimes := make([]string, 0, len(r.Content))
+				for mime := range r.Content {
+					mimes = append(mimes, mime)
+				}
+				sort.Strings(mimes)
+				for _, mime := range mimes {
+					mto := r.Content[mime]
+					mt := mediaTypeFor(mto.Schema.Ref, mto.Schema, mime)
+					resp.Bodies = append(resp.Bodies, &imp.Body{MIMEType: mime, MediaType: mt.TypeName})
+				}
+				act.Responses = append(act.Responses, resp)
+			}
+			res.Actions = append(res.Actions, act)
+		}
+	}
+	for _, name := range order {
+		api.Resources = append(api.Resources, byResource[name])
+	}
+	return api, nil
+}
+
+// firstContent returns the media type object for the lexicographically
+// first MIME type declared in content, used to pick the single type of a
+// request body: unlike responses, goa's Payload DSL has no multi-body
+// equivalent to Response/Body, so only one content type can be kept and
+// doing so deterministically keeps repeated imports reproducible.
+func firstContent(content map[string]*MediaTypeObject) (mime string, mto *MediaTypeObject, ok bool) {
+	if len(content) == 0 {
+		return "", nil, false
+	}
+	mimes := make([]string, 0, len(content))
+	for m := range content {
+		mimes = append(mimes, m)
+	}
+	sort.Strings(mimes)
+	mime = mimes[0]
+	return mime, content[mime], true
+}
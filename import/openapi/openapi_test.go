@@ -0,0 +1,63 @@
+package openapi
+
+import (
+	"strings"
+	"testing"
+
+	imp "goa.design/goa.v2/import"
+)
+
+func TestImportMultiContentAndHeaderParams(t *testing.T) {
+	doc := &Document{}
+	doc.Info.Title = "Bottles"
+	doc.Components.Schemas = map[string]*Schema{
+		"Bottle": {
+			Type: "object",
+			Properties: map[string]*Schema{
+				"name": {Type: "string"},
+			},
+		},
+	}
+	doc.Paths = map[string]map[string]*Operation{
+		"/bottles/{id}": {
+			"get": {
+				OperationID: "show",
+				Parameters: []*Param{
+					{Name: "id", In: "path", Required: true, Schema: &Schema{Type: "string"}},
+					{Name: "X-Request-Id", In: "header", Schema: &Schema{Type: "string"}},
+				},
+				Responses: map[string]*ResponseRef{
+					"200": {
+						Content: map[string]*MediaTypeObject{
+							"application/json": {Schema: &Schema{Ref: "#/components/schemas/Bottle"}},
+							"application/xml":  {Schema: &Schema{Ref: "#/components/schemas/Bottle"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	api, err := Import("", doc)
+	if err != nil {
+		t.Fatalf("Import failed: %s", err)
+	}
+	src, err := imp.Generate("design", api)
+	if err != nil {
+		t.Fatalf("Generate failed: %s", err)
+	}
+	out := string(src)
+
+	for _, want := range []string{
+		`Body("application/json", BottleMedia)`,
+		`Body("application/xml", BottleMedia)`,
+		`Header("X-Request-Id")`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("generated design missing %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, `Param("X-Request-Id"`) {
+		t.Errorf("header parameter was also rendered as a Param, got:\n%s", out)
+	}
+}
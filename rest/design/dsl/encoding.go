@@ -0,0 +1,57 @@
+package dsl
+
+import (
+	apidesign "goa.design/goa.v2/design"
+	"goa.design/goa.v2/eval"
+	"goa.design/goa.v2/rest/design"
+)
+
+// Encoding registers an encoder/decoder pair for one or more MIME types.
+// Used at the API level it makes the pair available to every response and
+// request in the design, used inside a Response DSL function it only
+// applies to that response:
+//
+//        var _ = API("bottle", func() {
+//                Encoding("application/msgpack", "goa.design/goa.v2/rest/codec/msgpack", "NewEncoder")
+//        })
+//
+//        Response(OK, func() {
+//                Body("application/vnd.proto", BottleMedia)
+//                Encoding("application/vnd.proto", "goa.design/goa.v2/rest/codec/protobuf", "NewEncoder")
+//        })
+//
+// Encoding registered inside a Response DSL function applies to the whole
+// response, there is currently no way to scope an encoder override to a
+// single Body/media type within a response that declares several.
+//
+// goa registers default encoder/decoder pairs for "application/json",
+// "application/xml" and "application/gob" (see design.DefaultEncodings), so
+// Encoding only needs to be used for additional formats such as protocol
+// buffers, MessagePack, CBOR or form-url-encoded.
+//
+// Encoding accepts either a single MIME type or a slice of MIME types as
+// first argument, followed by the import path of the package that
+// implements the encoder/decoder and the name of its constructor function.
+func Encoding(mimeTypes interface{}, packagePath, function string) {
+	var mts []string
+	switch t := mimeTypes.(type) {
+	case string:
+		mts = []string{t}
+	case []string:
+		mts = t
+	default:
+		eval.ReportError("invalid MIME type(s) %#v, must be a string or a slice of strings", mimeTypes)
+		return
+	}
+	enc := &design.EncodingExpr{MIMETypes: mts, PackagePath: packagePath, Function: function}
+	switch def := eval.Current().(type) {
+	case *apidesign.APIExpr:
+		design.Root.Encodings = append(design.Root.Encodings, enc)
+
+	case *design.HTTPResponseExpr:
+		def.Encodings = append(def.Encodings, enc)
+
+	default:
+		eval.IncompatibleDSL()
+	}
+}
@@ -0,0 +1,62 @@
+package dsl
+
+import (
+	apidesign "goa.design/goa.v2/design"
+	"goa.design/goa.v2/eval"
+	"goa.design/goa.v2/rest/design"
+)
+
+// ErrorResponse defines a response that renders a RFC 7807 "problem detail"
+// document (https://tools.ietf.org/html/rfc7807). The generated Go type
+// carries the canonical "type", "title", "status", "detail" and "instance"
+// fields. Additional members may be declared using Attribute in the
+// optional DSL function, in which case they are exposed as typed fields on
+// the generated struct; any extension member that is not declared this way
+// is rendered as part of a generic map:
+//
+//        ErrorResponse("NotFound", http.StatusNotFound, func() {
+//                Attribute("resource", String, "Name of missing resource")
+//                Required("resource")
+//        })
+//
+// ErrorResponse must be used at the API or Resource level, the resulting
+// response is then referenced from an action using Response(name):
+//
+//        Response(NotFound)
+//
+// The first argument of ErrorResponse is the response name, the second is
+// the response HTTP status which must be a 4xx or 5xx status code.
+func ErrorResponse(name string, status int, dsl ...func()) {
+	if status < 400 || status > 599 {
+		eval.ReportError("status must be a 4xx or 5xx status code, got %d", status)
+		return
+	}
+	mt := design.NewProblemMediaType(name, dsl...)
+	resp := &design.HTTPResponseExpr{
+		Name:      name,
+		Status:    status,
+		MediaType: mt.Identifier,
+		Type:      mt,
+		Standard:  true,
+	}
+	switch def := eval.Current().(type) {
+	case *apidesign.APIExpr:
+		if design.Root.Response(name) != nil {
+			eval.ReportError("response %s is defined twice", name)
+			return
+		}
+		resp.Parent = def
+		design.Root.Responses = append(design.Root.Responses, resp)
+
+	case *design.ResourceExpr:
+		if def.Response(name) != nil {
+			eval.ReportError("response %s is defined twice", name)
+			return
+		}
+		resp.Parent = def
+		def.Responses = append(def.Responses, resp)
+
+	default:
+		eval.IncompatibleDSL()
+	}
+}
@@ -40,6 +40,12 @@ import (
 //                Status(201)                  // Set response status (overrides template's)
 //        })
 //
+//        Response(OK, func() {
+//                Body("application/json", BottleMedia)    // Content negotiation: the same
+//                Body("application/xml", BottleMediaXML)  // status renders a different body
+//                Body("application/msgpack", BottleMedia) // depending on the request Accept
+//        })                                                // header.
+//
 //        Response("MyResponse", func() {      // Define custom response (using no template)
 //                Description("This is my response")
 //                Media(BottleMedia)
@@ -116,6 +122,45 @@ func Status(status int) {
 	res.Status = status
 }
 
+// Body registers an additional body that the response may render for the
+// given media type identifier. Body may be used multiple times on the same
+// response to enable content negotiation, in which case the generated
+// handler picks the body whose media type best matches the request "Accept"
+// header:
+//
+//        Response(OK, func() {
+//                Body("application/json", BottleMedia)
+//                Body("application/xml", BottleMediaXML)
+//        })
+//
+// Body takes the media type identifier as first argument and the data type
+// as second argument. The data type may be a media type defined in the
+// design, a user type or a primitive type. An optional third argument may be
+// used to select the view used to render the body when the type is a media
+// type that defines multiple views:
+//
+//        Body("application/json", BottleMedia, "tiny")
+func Body(mediaType string, dt apidesign.DataType, view ...string) {
+	res, ok := eval.Current().(*design.HTTPResponseExpr)
+	if !ok {
+		eval.IncompatibleDSL()
+		return
+	}
+	if res.Body(mediaType) != nil {
+		eval.ReportError("body for media type %#v is defined twice", mediaType)
+		return
+	}
+	v := ""
+	if len(view) > 0 {
+		v = view[0]
+	}
+	res.Bodies = append(res.Bodies, &design.ResponseBodyExpr{
+		MediaType: mediaType,
+		Type:      dt,
+		View:      v,
+	})
+}
+
 func executeResponseDSL(name string, paramsAndDSL ...interface{}) *design.HTTPResponseExpr {
 	var params []string
 	var dsl func()
@@ -0,0 +1,42 @@
+package design
+
+// EncodingExpr describes an encoder/decoder pair registered for one or more
+// MIME types via the Encoding DSL. The generated server and client pick the
+// pair whose MIMETypes best matches the request "Content-Type"/"Accept"
+// header.
+type EncodingExpr struct {
+	// MIMETypes lists the MIME types the encoder/decoder pair handles,
+	// e.g. "application/json".
+	MIMETypes []string
+	// PackagePath is the import path of the package that implements the
+	// encoder/decoder, e.g. "goa.design/goa.v2/rest/codec/msgpack".
+	PackagePath string
+	// Function is the name of the package function that returns the
+	// encoder/decoder, it must have the same signature as the functions
+	// registered by default (see DefaultEncodings).
+	Function string
+}
+
+// DefaultEncodings lists the encoder/decoder pairs goa registers
+// automatically so that designs do not have to declare Encoding for the
+// most common MIME types.
+var DefaultEncodings = []*EncodingExpr{
+	{MIMETypes: []string{"application/json"}, PackagePath: "encoding/json", Function: "NewEncoder"},
+	{MIMETypes: []string{"application/xml"}, PackagePath: "encoding/xml", Function: "NewEncoder"},
+	{MIMETypes: []string{"application/gob"}, PackagePath: "encoding/gob", Function: "NewEncoder"},
+}
+
+// HasEncoder returns true if either extra or DefaultEncodings registers an
+// encoder/decoder pair for mediaType.
+func HasEncoder(mediaType string, extra []*EncodingExpr) bool {
+	for _, list := range [][]*EncodingExpr{extra, DefaultEncodings} {
+		for _, enc := range list {
+			for _, mt := range enc.MIMETypes {
+				if mt == mediaType {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
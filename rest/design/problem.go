@@ -0,0 +1,33 @@
+package design
+
+import (
+	apidesign "goa.design/goa.v2/design"
+)
+
+// ProblemMediaTypeIdentifier is the media type identifier used by error
+// responses generated with the ErrorResponse DSL, see RFC 7807
+// (https://tools.ietf.org/html/rfc7807).
+const ProblemMediaTypeIdentifier = "application/problem+json"
+
+// NewProblemMediaType creates the media type describing a RFC 7807
+// problem+json document for the given name. The returned media type defines
+// the canonical "type", "title", "status", "detail" and "instance"
+// attributes. Any Attribute declared in the given DSL function is added as a
+// sibling of the canonical attributes, so it is generated as a regular typed
+// field just like them; the "extensions" attribute is the generic catch-all
+// for any member the document carries that was not declared this way and is
+// always rendered as a map[string]interface{}.
+func NewProblemMediaType(name string, dsl ...func()) *apidesign.MediaTypeExpr {
+	return apidesign.NewMediaType(ProblemMediaTypeIdentifier, name, func() {
+		apidesign.Attribute("type", apidesign.String, "A URI reference that identifies the problem type")
+		apidesign.Attribute("title", apidesign.String, "A short, human-readable summary of the problem type")
+		apidesign.Attribute("status", apidesign.Integer, "The HTTP status code for this occurrence of the problem")
+		apidesign.Attribute("detail", apidesign.String, "A human-readable explanation specific to this occurrence of the problem")
+		apidesign.Attribute("instance", apidesign.String, "A URI reference that identifies the specific occurrence of the problem")
+		apidesign.Attribute("extensions", apidesign.HashOf(apidesign.String, apidesign.Any), "Additional members specific to this problem type that were not declared as typed attributes")
+		apidesign.Required("type", "title", "status")
+		if len(dsl) > 0 {
+			dsl[0]()
+		}
+	})
+}
@@ -0,0 +1,129 @@
+package design
+
+import (
+	"fmt"
+
+	apidesign "goa.design/goa.v2/design"
+	"goa.design/goa.v2/eval"
+)
+
+// HTTPResponseExpr defines a HTTP response including its status code,
+// headers and body. Response definitions are set via the Response DSL.
+type HTTPResponseExpr struct {
+	// Name is the response name.
+	Name string
+	// Description is the response description.
+	Description string
+	// Status is the response HTTP status code.
+	Status int
+	// MediaType is the identifier of the media type used to render the
+	// response body when Bodies is not set.
+	MediaType string
+	// Type is the type of the response body when Bodies is not set.
+	Type apidesign.DataType
+	// Bodies lists the response bodies available for content
+	// negotiation, one per media type. When set it takes precedence
+	// over MediaType and Type.
+	Bodies []*ResponseBodyExpr
+	// Encodings lists the encoder/decoder pairs registered on the
+	// response via the Encoding DSL, overriding or complementing the
+	// ones registered at the API level.
+	Encodings []*EncodingExpr
+	// Headers is the response header definitions.
+	Headers *apidesign.AttributeExpr
+	// Parent is the parent expression (one of ActionExpr or ResourceExpr).
+	Parent eval.Expression
+	// Metadata is a set of key/value pairs with semantic meaning used in
+	// code generation.
+	Metadata apidesign.MetadataExpr
+	// Standard is true if the response is the default response template
+	// defined for the corresponding HTTP status code.
+	Standard bool
+}
+
+// ResponseBodyExpr describes a single media-type/body pair that a response
+// may render depending on the value of the request "Accept" header. Bodies
+// are added to a response via the Body DSL.
+type ResponseBodyExpr struct {
+	// MediaType is the media type identifier, e.g. "application/json".
+	MediaType string
+	// Type is the body type.
+	Type apidesign.DataType
+	// View is the view used to render Type when Type is a media type.
+	View string
+}
+
+// Dup creates a copy of the response expression.
+func (r *HTTPResponseExpr) Dup() *HTTPResponseExpr {
+	dup := &HTTPResponseExpr{
+		Name:        r.Name,
+		Description: r.Description,
+		Status:      r.Status,
+		MediaType:   r.MediaType,
+		Type:        r.Type,
+		Headers:     r.Headers,
+		Parent:      r.Parent,
+		Metadata:    r.Metadata,
+		Standard:    r.Standard,
+	}
+	if len(r.Bodies) > 0 {
+		dup.Bodies = make([]*ResponseBodyExpr, len(r.Bodies))
+		for i, b := range r.Bodies {
+			bdup := *b
+			dup.Bodies[i] = &bdup
+		}
+	}
+	if len(r.Encodings) > 0 {
+		dup.Encodings = make([]*EncodingExpr, len(r.Encodings))
+		copy(dup.Encodings, r.Encodings)
+	}
+	return dup
+}
+
+// Body returns the response body registered for the given media type
+// identifier, nil if none.
+func (r *HTTPResponseExpr) Body(mediaType string) *ResponseBodyExpr {
+	for _, b := range r.Bodies {
+		if b.MediaType == mediaType {
+			return b
+		}
+	}
+	return nil
+}
+
+// EvalName returns the generic expression name used in error messages.
+func (r *HTTPResponseExpr) EvalName() string {
+	if r.Name == "" {
+		return "unnamed response"
+	}
+	return fmt.Sprintf("response %#v", r.Name)
+}
+
+// Validate makes sure the bodies registered on the response are each
+// compatible with the view they reference and that there is no more than
+// one body per media type.
+func (r *HTTPResponseExpr) Validate() *eval.ValidationErrors {
+	verr := new(eval.ValidationErrors)
+	seen := make(map[string]struct{}, len(r.Bodies))
+	for _, b := range r.Bodies {
+		if _, ok := seen[b.MediaType]; ok {
+			verr.Add(r, "media type %#v is defined in more than one Body", b.MediaType)
+			continue
+		}
+		seen[b.MediaType] = struct{}{}
+		mt, ok := b.Type.(*apidesign.MediaTypeExpr)
+		if !ok {
+			continue
+		}
+		if b.View != "" && mt.View(b.View) == nil {
+			verr.Add(r, "view %#v is not defined on media type %#v", b.View, mt.Identifier)
+		}
+	}
+	encodings := append(append([]*EncodingExpr{}, r.Encodings...), Root.Encodings...)
+	for _, b := range r.Bodies {
+		if !HasEncoder(b.MediaType, encodings) {
+			verr.Add(r, "no encoder registered for media type %#v, use the Encoding DSL to register one", b.MediaType)
+		}
+	}
+	return verr
+}
@@ -0,0 +1,75 @@
+package design
+
+import (
+	"testing"
+
+	apidesign "goa.design/goa.v2/design"
+)
+
+func TestHTTPResponseExprValidate(t *testing.T) {
+	mt := apidesign.NewMediaType("application/vnd.bottle+json", "Bottle", func() {
+		apidesign.Attribute("name", apidesign.String, "the bottle name")
+	})
+
+	cases := []struct {
+		name    string
+		resp    *HTTPResponseExpr
+		wantErr bool
+	}{
+		{
+			name: "single body with a default encoder",
+			resp: &HTTPResponseExpr{
+				Bodies: []*ResponseBodyExpr{
+					{MediaType: "application/json", Type: mt},
+				},
+			},
+		},
+		{
+			name: "body with no registered encoder",
+			resp: &HTTPResponseExpr{
+				Bodies: []*ResponseBodyExpr{
+					{MediaType: "application/vnd.proto", Type: mt},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "body registered via a response-level Encoding",
+			resp: &HTTPResponseExpr{
+				Bodies: []*ResponseBodyExpr{
+					{MediaType: "application/vnd.proto", Type: mt},
+				},
+				Encodings: []*EncodingExpr{
+					{MIMETypes: []string{"application/vnd.proto"}, PackagePath: "goa.design/goa.v2/rest/codec/protobuf", Function: "NewEncoder"},
+				},
+			},
+		},
+		{
+			name: "same media type declared in more than one Body",
+			resp: &HTTPResponseExpr{
+				Bodies: []*ResponseBodyExpr{
+					{MediaType: "application/json", Type: mt},
+					{MediaType: "application/json", Type: mt},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "view not defined on the media type",
+			resp: &HTTPResponseExpr{
+				Bodies: []*ResponseBodyExpr{
+					{MediaType: "application/json", Type: mt, View: "tiny"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			verr := c.resp.Validate()
+			if got := len(verr.Errors) > 0; got != c.wantErr {
+				t.Errorf("Validate() errors = %v, want any error = %v", verr.Errors, c.wantErr)
+			}
+		})
+	}
+}
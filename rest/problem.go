@@ -0,0 +1,51 @@
+package rest
+
+import "net/http"
+
+// Problem is the data structure rendered by NewErrorResponse, it follows the
+// RFC 7807 "problem detail" format (https://tools.ietf.org/html/rfc7807).
+// Types generated from media types created with the ErrorResponse DSL embed
+// the same canonical fields.
+type Problem struct {
+	// Type is a URI reference that identifies the problem type.
+	Type string `json:"type" xml:"type"`
+	// Title is a short, human-readable summary of the problem type.
+	Title string `json:"title" xml:"title"`
+	// Status is the HTTP status code for this occurrence of the problem.
+	Status int `json:"status" xml:"status"`
+	// Detail is a human-readable explanation specific to this occurrence
+	// of the problem.
+	Detail string `json:"detail,omitempty" xml:"detail,omitempty"`
+	// Instance is a URI reference that identifies the specific
+	// occurrence of the problem.
+	Instance string `json:"instance,omitempty" xml:"instance,omitempty"`
+	// Extensions holds any additional member declared via an Attribute
+	// in the ErrorResponse DSL that is not one of the canonical fields
+	// above.
+	Extensions map[string]interface{} `json:"extensions,omitempty" xml:"extensions,omitempty"`
+}
+
+// NewErrorResponse wraps err into a Problem document using status for the
+// "status" and "title" fields and err.Error() for the "detail" field. The
+// "type" field defaults to "about:blank" as recommended by RFC 7807 when the
+// problem has no more specific URI. The optional extensions are merged into
+// the "extensions" member, it is the caller's responsibility to populate it
+// with the values of any attribute declared in the media type's
+// ErrorResponse DSL.
+func NewErrorResponse(err error, status int, extensions ...map[string]interface{}) *Problem {
+	p := &Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: err.Error(),
+	}
+	for _, ext := range extensions {
+		if p.Extensions == nil {
+			p.Extensions = make(map[string]interface{}, len(ext))
+		}
+		for k, v := range ext {
+			p.Extensions[k] = v
+		}
+	}
+	return p
+}